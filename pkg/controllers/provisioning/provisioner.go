@@ -318,7 +318,10 @@ func (p *Provisioner) Schedule(ctx context.Context) (scheduler.Results, error) {
 	if err != nil {
 		return scheduler.Results{}, err
 	}
-	pods := append(pendingPods, deletingNodePods...)
+	// Combine into a freshly allocated slice so that appending can't write into pendingPods' backing array
+	pods := make([]*v1.Pod, 0, len(pendingPods)+len(deletingNodePods))
+	pods = append(pods, pendingPods...)
+	pods = append(pods, deletingNodePods...)
 	// nothing to schedule, so just return success
 	if len(pods) == 0 {
 		return scheduler.Results{}, nil
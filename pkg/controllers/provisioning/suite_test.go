@@ -120,6 +120,25 @@ var _ = Describe("Provisioning", func() {
 		Expect(len(nodes.Items)).To(Equal(0))
 		ExpectNotScheduled(ctx, env.Client, pod)
 	})
+	It("should skip NodePools whose instance types can't be resolved", func() {
+		badNodePool := test.NodePool(v1beta1.NodePool{Spec: v1beta1.NodePoolSpec{Weight: lo.ToPtr[int32](100)}})
+		goodNodePool := test.NodePool()
+		cloudProvider.ErrorsForNodePool[badNodePool.Name] = fmt.Errorf("unable to fetch instance types")
+		ExpectApplied(ctx, env.Client, badNodePool, goodNodePool)
+		pod := test.UnschedulablePod()
+		ExpectProvisioned(ctx, env.Client, cluster, cloudProvider, prov, pod)
+		node := ExpectScheduled(ctx, env.Client, pod)
+		Expect(node.Labels[v1beta1.NodePoolLabelKey]).To(Equal(goodNodePool.Name))
+	})
+	It("should not provision nodes when no NodePool's instance types can be resolved", func() {
+		nodePool := test.NodePool()
+		cloudProvider.ErrorsForNodePool[nodePool.Name] = fmt.Errorf("unable to fetch instance types")
+		ExpectApplied(ctx, env.Client, nodePool)
+		pod := test.UnschedulablePod()
+		ExpectProvisioned(ctx, env.Client, cluster, cloudProvider, prov, pod)
+		Expect(cloudProvider.CreateCalls).To(BeEmpty())
+		ExpectNotScheduled(ctx, env.Client, pod)
+	})
 	It("should provision nodes for pods with supported node selectors", func() {
 		nodePool := test.NodePool()
 		schedulable := []*v1.Pod{